@@ -0,0 +1,170 @@
+package calculator
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// BigIntCalculator is the arbitrary-precision integer variant of Calculator,
+// backed by math/big.Int. Unlike Calculator[T], it never overflows.
+type BigIntCalculator struct {
+	value *big.Int
+	undo  []*big.Int
+	redo  []*big.Int
+}
+
+// NewBigInt creates a BigIntCalculator seeded with initial. initial is
+// retained, not copied; callers should not mutate it afterward.
+func NewBigInt(initial *big.Int) *BigIntCalculator {
+	return &BigIntCalculator{value: initial}
+}
+
+// GetValue returns the current value.
+func (c *BigIntCalculator) GetValue() *big.Int {
+	return c.value
+}
+
+// Reset sets the current value back to zero.
+func (c *BigIntCalculator) Reset() {
+	c.push()
+	c.value = big.NewInt(0)
+}
+
+// Add adds x to the current value.
+func (c *BigIntCalculator) Add(x *big.Int) {
+	c.push()
+	c.value = new(big.Int).Add(c.value, x)
+}
+
+// Subtract subtracts x from the current value.
+func (c *BigIntCalculator) Subtract(x *big.Int) {
+	c.push()
+	c.value = new(big.Int).Sub(c.value, x)
+}
+
+// Multiply multiplies the current value by x.
+func (c *BigIntCalculator) Multiply(x *big.Int) {
+	c.push()
+	c.value = new(big.Int).Mul(c.value, x)
+}
+
+// Divide divides the current value by x using truncated integer division.
+func (c *BigIntCalculator) Divide(x *big.Int) error {
+	if x.Sign() == 0 {
+		return fmt.Errorf("division by zero")
+	}
+	c.push()
+	c.value = new(big.Int).Quo(c.value, x)
+	return nil
+}
+
+func (c *BigIntCalculator) push() {
+	c.undo = append(c.undo, c.value)
+	c.redo = c.redo[:0]
+}
+
+// Undo reverts the last mutating operation.
+func (c *BigIntCalculator) Undo() bool {
+	if len(c.undo) == 0 {
+		return false
+	}
+	last := len(c.undo) - 1
+	c.redo = append(c.redo, c.value)
+	c.value = c.undo[last]
+	c.undo = c.undo[:last]
+	return true
+}
+
+// Redo reapplies the last undone operation.
+func (c *BigIntCalculator) Redo() bool {
+	if len(c.redo) == 0 {
+		return false
+	}
+	last := len(c.redo) - 1
+	c.undo = append(c.undo, c.value)
+	c.value = c.redo[last]
+	c.redo = c.redo[:last]
+	return true
+}
+
+// BigFloatCalculator is the arbitrary-precision float variant of Calculator,
+// backed by math/big.Float.
+type BigFloatCalculator struct {
+	value *big.Float
+	undo  []*big.Float
+	redo  []*big.Float
+}
+
+// NewBigFloat creates a BigFloatCalculator seeded with initial. initial is
+// retained, not copied; callers should not mutate it afterward.
+func NewBigFloat(initial *big.Float) *BigFloatCalculator {
+	return &BigFloatCalculator{value: initial}
+}
+
+// GetValue returns the current value.
+func (c *BigFloatCalculator) GetValue() *big.Float {
+	return c.value
+}
+
+// Reset sets the current value back to zero.
+func (c *BigFloatCalculator) Reset() {
+	c.push()
+	c.value = big.NewFloat(0)
+}
+
+// Add adds x to the current value.
+func (c *BigFloatCalculator) Add(x *big.Float) {
+	c.push()
+	c.value = new(big.Float).Add(c.value, x)
+}
+
+// Subtract subtracts x from the current value.
+func (c *BigFloatCalculator) Subtract(x *big.Float) {
+	c.push()
+	c.value = new(big.Float).Sub(c.value, x)
+}
+
+// Multiply multiplies the current value by x.
+func (c *BigFloatCalculator) Multiply(x *big.Float) {
+	c.push()
+	c.value = new(big.Float).Mul(c.value, x)
+}
+
+// Divide divides the current value by x.
+func (c *BigFloatCalculator) Divide(x *big.Float) error {
+	if x.Sign() == 0 {
+		return fmt.Errorf("division by zero")
+	}
+	c.push()
+	c.value = new(big.Float).Quo(c.value, x)
+	return nil
+}
+
+func (c *BigFloatCalculator) push() {
+	c.undo = append(c.undo, c.value)
+	c.redo = c.redo[:0]
+}
+
+// Undo reverts the last mutating operation.
+func (c *BigFloatCalculator) Undo() bool {
+	if len(c.undo) == 0 {
+		return false
+	}
+	last := len(c.undo) - 1
+	c.redo = append(c.redo, c.value)
+	c.value = c.undo[last]
+	c.undo = c.undo[:last]
+	return true
+}
+
+// Redo reapplies the last undone operation.
+func (c *BigFloatCalculator) Redo() bool {
+	if len(c.redo) == 0 {
+		return false
+	}
+	last := len(c.redo) - 1
+	c.undo = append(c.undo, c.value)
+	c.value = c.redo[last]
+	c.redo = c.redo[:last]
+	return true
+}
@@ -0,0 +1,80 @@
+package calculator
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestBigIntCalculatorArithmeticAndUndoRedo(t *testing.T) {
+	c := NewBigInt(big.NewInt(10))
+
+	c.Add(big.NewInt(5))
+	if c.GetValue().Cmp(big.NewInt(15)) != 0 {
+		t.Fatalf("Add: got %v, want 15", c.GetValue())
+	}
+
+	c.Multiply(big.NewInt(3))
+	if c.GetValue().Cmp(big.NewInt(45)) != 0 {
+		t.Fatalf("Multiply: got %v, want 45", c.GetValue())
+	}
+
+	c.Subtract(big.NewInt(5))
+	if c.GetValue().Cmp(big.NewInt(40)) != 0 {
+		t.Fatalf("Subtract: got %v, want 40", c.GetValue())
+	}
+
+	if !c.Undo() || c.GetValue().Cmp(big.NewInt(45)) != 0 {
+		t.Fatalf("Undo: got %v, want 45", c.GetValue())
+	}
+	if !c.Redo() || c.GetValue().Cmp(big.NewInt(40)) != 0 {
+		t.Fatalf("Redo: got %v, want 40", c.GetValue())
+	}
+}
+
+func TestBigIntCalculatorDivideByZero(t *testing.T) {
+	c := NewBigInt(big.NewInt(10))
+	if err := c.Divide(big.NewInt(0)); err == nil {
+		t.Fatalf("Divide(0): want error, got nil")
+	}
+	if c.GetValue().Cmp(big.NewInt(10)) != 0 {
+		t.Fatalf("Divide(0): value changed to %v despite error", c.GetValue())
+	}
+}
+
+func TestBigIntCalculatorNeverOverflows(t *testing.T) {
+	huge := new(big.Int).Lsh(big.NewInt(1), 256)
+	c := NewBigInt(new(big.Int).Set(huge))
+	c.Multiply(huge)
+	want := new(big.Int).Mul(huge, huge)
+	if c.GetValue().Cmp(want) != 0 {
+		t.Fatalf("Multiply(huge) = %v, want %v", c.GetValue(), want)
+	}
+}
+
+func TestBigFloatCalculatorArithmeticAndUndoRedo(t *testing.T) {
+	c := NewBigFloat(big.NewFloat(1.5))
+
+	c.Add(big.NewFloat(0.5))
+	if f, _ := c.GetValue().Float64(); f != 2 {
+		t.Fatalf("Add: got %v, want 2", f)
+	}
+
+	c.Multiply(big.NewFloat(2))
+	if f, _ := c.GetValue().Float64(); f != 4 {
+		t.Fatalf("Multiply: got %v, want 4", f)
+	}
+
+	if !c.Undo() {
+		t.Fatalf("Undo: want true")
+	}
+	if f, _ := c.GetValue().Float64(); f != 2 {
+		t.Fatalf("Undo: got %v, want 2", f)
+	}
+}
+
+func TestBigFloatCalculatorDivideByZero(t *testing.T) {
+	c := NewBigFloat(big.NewFloat(10))
+	if err := c.Divide(big.NewFloat(0)); err == nil {
+		t.Fatalf("Divide(0): want error, got nil")
+	}
+}
@@ -0,0 +1,126 @@
+// Package calculator provides a generic, type-safe stateful calculator plus
+// a math/big-backed variant for arbitrary precision.
+package calculator
+
+import "fmt"
+
+// Calculator accumulates a running numeric value of type T, with
+// overflow-checked Add/Multiply, an expression evaluator, and an undo/redo
+// history.
+type Calculator[T Numeric] struct {
+	value T
+	undo  []T
+	redo  []T
+}
+
+// New creates a new Calculator seeded with initial.
+func New[T Numeric](initial T) *Calculator[T] {
+	return &Calculator[T]{value: initial}
+}
+
+// GetValue returns the current value.
+func (c *Calculator[T]) GetValue() T {
+	return c.value
+}
+
+// Reset sets the current value back to the zero value of T.
+func (c *Calculator[T]) Reset() {
+	c.push()
+	var zero T
+	c.value = zero
+}
+
+// Add adds x to the current value, returning ErrOverflow without changing
+// the value if the result would overflow.
+func (c *Calculator[T]) Add(x T) error {
+	sum := c.value + x
+	if addOverflowed(c.value, x, sum) {
+		return ErrOverflow
+	}
+	c.push()
+	c.value = sum
+	return nil
+}
+
+// Subtract subtracts x from the current value, returning ErrOverflow
+// without changing the value if the result would overflow or underflow.
+func (c *Calculator[T]) Subtract(x T) error {
+	diff := c.value - x
+	if subOverflowed(c.value, x, diff) {
+		return ErrOverflow
+	}
+	c.push()
+	c.value = diff
+	return nil
+}
+
+// Multiply multiplies the current value by x, returning ErrOverflow without
+// changing the value if the result would overflow.
+func (c *Calculator[T]) Multiply(x T) error {
+	product := c.value * x
+	if mulOverflowed(c.value, x, product) {
+		return ErrOverflow
+	}
+	c.push()
+	c.value = product
+	return nil
+}
+
+// Divide divides the current value by x. For Integer types this truncates
+// like native Go integer division; for Float types it performs ordinary
+// float division.
+func (c *Calculator[T]) Divide(x T) error {
+	var zero T
+	if x == zero {
+		return fmt.Errorf("division by zero")
+	}
+	c.push()
+	c.value = c.value / x
+	return nil
+}
+
+// push records the current value on the undo stack and clears the redo
+// stack, since a new mutation invalidates any previously undone redo path.
+func (c *Calculator[T]) push() {
+	c.undo = append(c.undo, c.value)
+	c.redo = c.redo[:0]
+}
+
+// Undo reverts the last mutating operation (Add, Subtract, Multiply,
+// Divide, Reset, or Eval), returning false if there is nothing to undo.
+func (c *Calculator[T]) Undo() bool {
+	if len(c.undo) == 0 {
+		return false
+	}
+	last := len(c.undo) - 1
+	c.redo = append(c.redo, c.value)
+	c.value = c.undo[last]
+	c.undo = c.undo[:last]
+	return true
+}
+
+// Redo reapplies the last undone operation, returning false if there is
+// nothing to redo.
+func (c *Calculator[T]) Redo() bool {
+	if len(c.redo) == 0 {
+		return false
+	}
+	last := len(c.redo) - 1
+	c.undo = append(c.undo, c.value)
+	c.value = c.redo[last]
+	c.redo = c.redo[:last]
+	return true
+}
+
+// Eval evaluates expr (supporting + - * / with standard precedence) and
+// sets the current value to the result.
+func (c *Calculator[T]) Eval(expr string) (T, error) {
+	result, err := evalExpr[T](expr)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	c.push()
+	c.value = result
+	return result, nil
+}
@@ -0,0 +1,165 @@
+package calculator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// evalExpr evaluates expr, an expression of numbers combined with
+// + - * /, with * and / binding tighter than + and -.
+func evalExpr[T Numeric](expr string) (T, error) {
+	var zero T
+	tokens, err := tokenize(expr)
+	if err != nil {
+		return zero, err
+	}
+	p := &exprParser[T]{tokens: tokens}
+	result, err := p.parseExpr()
+	if err != nil {
+		return zero, err
+	}
+	if p.pos != len(p.tokens) {
+		return zero, fmt.Errorf("calculator: unexpected token %q", p.tokens[p.pos])
+	}
+	return result, nil
+}
+
+// tokenize splits expr into number and operator tokens, skipping whitespace.
+func tokenize(expr string) ([]string, error) {
+	var tokens []string
+	runes := []rune(expr)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '+' || r == '-' || r == '*' || r == '/':
+			tokens = append(tokens, string(r))
+			i++
+		case unicode.IsDigit(r) || r == '.':
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, string(runes[start:i]))
+		default:
+			return nil, fmt.Errorf("calculator: unexpected character %q", r)
+		}
+	}
+	return tokens, nil
+}
+
+type exprParser[T Numeric] struct {
+	tokens []string
+	pos    int
+}
+
+func (p *exprParser[T]) peek() (string, bool) {
+	if p.pos >= len(p.tokens) {
+		return "", false
+	}
+	return p.tokens[p.pos], true
+}
+
+// parseExpr parses term (('+' | '-') term)*.
+func (p *exprParser[T]) parseExpr() (T, error) {
+	result, err := p.parseTerm()
+	if err != nil {
+		return result, err
+	}
+	for {
+		op, ok := p.peek()
+		if !ok || (op != "+" && op != "-") {
+			return result, nil
+		}
+		p.pos++
+		rhs, err := p.parseTerm()
+		if err != nil {
+			var zero T
+			return zero, err
+		}
+		if op == "+" {
+			result = result + rhs
+		} else {
+			result = result - rhs
+		}
+	}
+}
+
+// parseTerm parses factor (('*' | '/') factor)*.
+func (p *exprParser[T]) parseTerm() (T, error) {
+	result, err := p.parseFactor()
+	if err != nil {
+		return result, err
+	}
+	for {
+		op, ok := p.peek()
+		if !ok || (op != "*" && op != "/") {
+			return result, nil
+		}
+		p.pos++
+		rhs, err := p.parseFactor()
+		if err != nil {
+			var zero T
+			return zero, err
+		}
+		if op == "*" {
+			result = result * rhs
+		} else {
+			var zero T
+			if rhs == zero {
+				return zero, fmt.Errorf("division by zero")
+			}
+			result = result / rhs
+		}
+	}
+}
+
+// parseFactor parses an optionally negated number.
+func (p *exprParser[T]) parseFactor() (T, error) {
+	var zero T
+	tok, ok := p.peek()
+	if !ok {
+		return zero, fmt.Errorf("calculator: unexpected end of expression")
+	}
+	if tok == "-" {
+		p.pos++
+		v, err := p.parseFactor()
+		if err != nil {
+			return zero, err
+		}
+		return -v, nil
+	}
+	if tok == "+" {
+		p.pos++
+		return p.parseFactor()
+	}
+
+	p.pos++
+	return parseNumber[T](tok)
+}
+
+// parseNumber converts a numeric literal to T, parsing it as a float for
+// Float types and as an integer for Integer types.
+func parseNumber[T Numeric](tok string) (T, error) {
+	var zero T
+	switch any(zero).(type) {
+	case float32, float64:
+		f, err := strconv.ParseFloat(tok, 64)
+		if err != nil {
+			return zero, fmt.Errorf("calculator: invalid number %q: %w", tok, err)
+		}
+		return T(f), nil
+	default:
+		if strings.Contains(tok, ".") {
+			return zero, fmt.Errorf("calculator: %q is not a valid integer literal", tok)
+		}
+		i, err := strconv.ParseInt(tok, 10, 64)
+		if err != nil {
+			return zero, fmt.Errorf("calculator: invalid number %q: %w", tok, err)
+		}
+		return T(i), nil
+	}
+}
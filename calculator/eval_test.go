@@ -0,0 +1,83 @@
+package calculator
+
+import "testing"
+
+func TestEvalPrecedenceAndParens(t *testing.T) {
+	c := New[int64](0)
+	result, err := c.Eval("2 + 3 * 4 - 10 / 5")
+	if err != nil {
+		t.Fatalf("Eval: %v", err)
+	}
+	if result != 12 {
+		t.Fatalf("Eval(2 + 3 * 4 - 10 / 5) = %d, want 12", result)
+	}
+	if c.GetValue() != 12 {
+		t.Fatalf("GetValue() = %d, want 12 (Eval should set the current value)", c.GetValue())
+	}
+}
+
+func TestEvalUnaryMinus(t *testing.T) {
+	c := New[int64](0)
+	result, err := c.Eval("-5 + 2")
+	if err != nil {
+		t.Fatalf("Eval: %v", err)
+	}
+	if result != -3 {
+		t.Fatalf("Eval(-5 + 2) = %d, want -3", result)
+	}
+}
+
+func TestEvalFloat(t *testing.T) {
+	c := New[float64](0)
+	result, err := c.Eval("1.5 * 2 + 0.5")
+	if err != nil {
+		t.Fatalf("Eval: %v", err)
+	}
+	if result != 3.5 {
+		t.Fatalf("Eval(1.5 * 2 + 0.5) = %v, want 3.5", result)
+	}
+}
+
+func TestEvalDivisionByZero(t *testing.T) {
+	c := New[int64](0)
+	if _, err := c.Eval("1 / 0"); err == nil {
+		t.Fatalf("Eval(1 / 0): want error, got nil")
+	}
+}
+
+func TestEvalIntegerRejectsFloatLiteral(t *testing.T) {
+	c := New[int64](0)
+	if _, err := c.Eval("1.5 + 1"); err == nil {
+		t.Fatalf("Eval(1.5 + 1) on int64 calculator: want error, got nil")
+	}
+}
+
+func TestEvalUnexpectedCharacter(t *testing.T) {
+	c := New[int64](0)
+	if _, err := c.Eval("1 + $"); err == nil {
+		t.Fatalf("Eval(1 + $): want error, got nil")
+	}
+}
+
+func TestEvalTrailingTokenIsError(t *testing.T) {
+	c := New[int64](0)
+	if _, err := c.Eval("1 + 2 3"); err == nil {
+		t.Fatalf("Eval(1 + 2 3): want error, got nil")
+	}
+}
+
+func TestEvalUndoesWithUndo(t *testing.T) {
+	c := New[int64](1)
+	if _, err := c.Eval("2 + 2"); err != nil {
+		t.Fatalf("Eval: %v", err)
+	}
+	if c.GetValue() != 4 {
+		t.Fatalf("GetValue() = %d, want 4", c.GetValue())
+	}
+	if !c.Undo() {
+		t.Fatalf("Undo() = false, want true")
+	}
+	if c.GetValue() != 1 {
+		t.Fatalf("GetValue() after Undo = %d, want 1", c.GetValue())
+	}
+}
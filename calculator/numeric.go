@@ -0,0 +1,20 @@
+package calculator
+
+// Integer is the set of built-in signed and unsigned integer types.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// Float is the set of built-in floating-point types.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Numeric is the set of types Calculator can be instantiated with. Divide
+// behaves as integer division for Integer types and as float division for
+// Float types, purely as a consequence of Go's native division semantics
+// for each underlying type.
+type Numeric interface {
+	Integer | Float
+}
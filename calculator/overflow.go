@@ -0,0 +1,100 @@
+package calculator
+
+import (
+	"errors"
+	"math"
+	"reflect"
+)
+
+// ErrOverflow is returned by Add and Multiply when the result would wrap
+// (for integer types) or overflow to +/-Inf (for float types).
+var ErrOverflow = errors.New("calculator: arithmetic overflow")
+
+// isInf reports whether v is +/-Inf, for float Numeric types; it is always
+// false for integer types. It checks v's reflect.Kind rather than its
+// concrete type, so it also recognizes named types such as type MyFloat
+// float64.
+func isInf[T Numeric](v T) bool {
+	switch reflect.ValueOf(v).Kind() {
+	case reflect.Float32, reflect.Float64:
+		return math.IsInf(reflect.ValueOf(v).Float(), 0)
+	default:
+		return false
+	}
+}
+
+// addOverflowed reports whether sum = a + b wrapped (integers) or overflowed
+// to Inf (floats).
+func addOverflowed[T Numeric](a, b, sum T) bool {
+	if isInf(sum) {
+		return !isInf(a) && !isInf(b)
+	}
+	if b >= 0 {
+		return sum < a
+	}
+	return sum > a
+}
+
+// subOverflowed reports whether diff = a - b wrapped (integers) or
+// overflowed to Inf (floats).
+func subOverflowed[T Numeric](a, b, diff T) bool {
+	if isInf(diff) {
+		return !isInf(a) && !isInf(b)
+	}
+	if b >= 0 {
+		return diff > a
+	}
+	return diff < a
+}
+
+// isNegOne reports whether v is the signed integer value -1. It is always
+// false for unsigned and float Numeric types. It switches on v's
+// reflect.Kind rather than its concrete type, so it also recognizes named
+// types such as type MyInt int, which a type switch on any(v) would miss.
+func isNegOne[T Numeric](v T) bool {
+	switch reflect.ValueOf(v).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return reflect.ValueOf(v).Int() == -1
+	default:
+		return false
+	}
+}
+
+// isMinInt reports whether v is the minimum representable value of its
+// signed integer type. It is always false for unsigned and float Numeric
+// types. Like isNegOne, it switches on v's reflect.Kind so named integer
+// types are handled the same as their builtin underlying type.
+func isMinInt[T Numeric](v T) bool {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int:
+		return rv.Int() == math.MinInt
+	case reflect.Int8:
+		return rv.Int() == math.MinInt8
+	case reflect.Int16:
+		return rv.Int() == math.MinInt16
+	case reflect.Int32:
+		return rv.Int() == math.MinInt32
+	case reflect.Int64:
+		return rv.Int() == math.MinInt64
+	default:
+		return false
+	}
+}
+
+// mulOverflowed reports whether product = a * b wrapped (integers) or
+// overflowed to Inf (floats). The integer check is the standard
+// division-based test, plus the MinInt*-1 special case: that product
+// wraps back to MinInt itself, so the division-based test alone misses it.
+func mulOverflowed[T Numeric](a, b, product T) bool {
+	if isInf(product) {
+		return !isInf(a) && !isInf(b)
+	}
+	if (isNegOne(a) && isMinInt(b)) || (isNegOne(b) && isMinInt(a)) {
+		return true
+	}
+	if a == 0 {
+		return false
+	}
+	return product/a != b
+}
@@ -0,0 +1,61 @@
+package calculator
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMultiplyMinIntTimesNegOne(t *testing.T) {
+	c := New[int64](-1)
+	err := c.Multiply(math.MinInt64)
+	if err != ErrOverflow {
+		t.Fatalf("Multiply(MinInt64) on -1: got err=%v, want ErrOverflow", err)
+	}
+	if c.GetValue() != -1 {
+		t.Fatalf("Multiply(MinInt64) on -1: value changed to %d despite overflow", c.GetValue())
+	}
+
+	c2 := New[int64](math.MinInt64)
+	err = c2.Multiply(-1)
+	if err != ErrOverflow {
+		t.Fatalf("Multiply(-1) on MinInt64: got err=%v, want ErrOverflow", err)
+	}
+	if c2.GetValue() != math.MinInt64 {
+		t.Fatalf("Multiply(-1) on MinInt64: value changed to %d despite overflow", c2.GetValue())
+	}
+}
+
+// MyInt is a named integer type distinct from int64, used to confirm the
+// MinInt*-1 overflow check also fires for defined types, not just the
+// builtin types it happens to switch on.
+type MyInt int64
+
+func TestMultiplyMinIntTimesNegOneNamedType(t *testing.T) {
+	c := New[MyInt](-1)
+	err := c.Multiply(MyInt(math.MinInt64))
+	if err != ErrOverflow {
+		t.Fatalf("Multiply(MinInt64) on -1 (named type): got err=%v, want ErrOverflow", err)
+	}
+	if c.GetValue() != -1 {
+		t.Fatalf("Multiply(MinInt64) on -1 (named type): value changed to %d despite overflow", c.GetValue())
+	}
+
+	c2 := New[MyInt](MyInt(math.MinInt64))
+	err = c2.Multiply(-1)
+	if err != ErrOverflow {
+		t.Fatalf("Multiply(-1) on MinInt64 (named type): got err=%v, want ErrOverflow", err)
+	}
+	if c2.GetValue() != MyInt(math.MinInt64) {
+		t.Fatalf("Multiply(-1) on MinInt64 (named type): value changed to %d despite overflow", c2.GetValue())
+	}
+}
+
+func TestMultiplyNoFalsePositive(t *testing.T) {
+	c := New[int64](6)
+	if err := c.Multiply(7); err != nil {
+		t.Fatalf("Multiply(7) on 6: unexpected error %v", err)
+	}
+	if c.GetValue() != 42 {
+		t.Fatalf("Multiply(7) on 6: got %d, want 42", c.GetValue())
+	}
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/seuros/dictator/calculator"
+	"github.com/seuros/dictator/output"
+)
+
+var calcFloat bool
+
+var calcCmd = &cobra.Command{
+	Use:   "calc <op> <initial> <operand>",
+	Short: "Run a single calculator operation (add, sub, mul, div)",
+	Args:  cobra.ExactArgs(3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if calcFloat {
+			return runCalc(args, parseCalcFloat)
+		}
+		return runCalc(args, parseCalcInt)
+	},
+}
+
+var calcEvalCmd = &cobra.Command{
+	Use:   "eval <expr>",
+	Short: "Evaluate an expression of + - * / with standard precedence",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if calcFloat {
+			return runEval(args[0], float64(0))
+		}
+		return runEval(args[0], int64(0))
+	},
+}
+
+func parseCalcInt(s string) (int64, error) {
+	return strconv.ParseInt(s, 10, 64)
+}
+
+func parseCalcFloat(s string) (float64, error) {
+	return strconv.ParseFloat(s, 64)
+}
+
+// runCalc parses args as "<op> <initial> <operand>" and runs the matching
+// Calculator[T] operation, using parse to convert numeric arguments.
+func runCalc[T calculator.Numeric](args []string, parse func(string) (T, error)) error {
+	op := args[0]
+
+	initial, err := parse(args[1])
+	if err != nil {
+		return fmt.Errorf("invalid initial value %q: %w", args[1], err)
+	}
+	operand, err := parse(args[2])
+	if err != nil {
+		return fmt.Errorf("invalid operand %q: %w", args[2], err)
+	}
+
+	c := calculator.New(initial)
+	switch op {
+	case "add":
+		if err := c.Add(operand); err != nil {
+			return err
+		}
+	case "sub":
+		if err := c.Subtract(operand); err != nil {
+			return err
+		}
+	case "mul":
+		if err := c.Multiply(operand); err != nil {
+			return err
+		}
+	case "div":
+		if err := c.Divide(operand); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown op %q: expected add, sub, mul, or div", op)
+	}
+
+	return output.Write(os.Stdout, currentFormat(), map[string]string{
+		"op":     op,
+		"result": fmt.Sprintf("%v", c.GetValue()),
+	})
+}
+
+// runEval evaluates expr against a zero-valued Calculator[T] and prints the
+// result; zero is only used to pin T for type inference.
+func runEval[T calculator.Numeric](expr string, zero T) error {
+	c := calculator.New(zero)
+	result, err := c.Eval(expr)
+	if err != nil {
+		return err
+	}
+	return output.Write(os.Stdout, currentFormat(), map[string]string{
+		"expr":   expr,
+		"result": fmt.Sprintf("%v", result),
+	})
+}
+
+func init() {
+	calcCmd.PersistentFlags().BoolVar(&calcFloat, "float", false, "operate on float64 values instead of int64")
+	calcCmd.AddCommand(calcEvalCmd)
+	rootCmd.AddCommand(calcCmd)
+}
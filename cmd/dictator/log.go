@@ -0,0 +1,51 @@
+package main
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/seuros/dictator/logger"
+	"github.com/seuros/dictator/output"
+)
+
+var (
+	logLevel    string
+	logEncoding string
+)
+
+var logCmd = &cobra.Command{
+	Use:   "log <message>",
+	Short: "Emit a log line at the given level (debug, info, warn, error, fatal)",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		level := logger.ParseLevel(logLevel)
+		l := logger.NewLogger(logger.LoggerConfig{
+			Encoding: logger.Encoding(logEncoding),
+		})
+
+		switch level {
+		case logger.Fatal:
+			l.Fatal(args[0])
+		case logger.Error:
+			l.Error(args[0])
+		case logger.Warn:
+			l.Warn(args[0])
+		case logger.Debug:
+			l.Debug(args[0])
+		default:
+			l.Info(args[0])
+		}
+
+		return output.Write(os.Stdout, currentFormat(), map[string]string{
+			"level":   level.String(),
+			"message": args[0],
+		})
+	},
+}
+
+func init() {
+	logCmd.Flags().StringVar(&logLevel, "level", "info", "log level: debug, info, warn, error, or fatal")
+	logCmd.Flags().StringVar(&logEncoding, "encoding", "text", "log encoding: text, json, or logfmt")
+	rootCmd.AddCommand(logCmd)
+}
@@ -0,0 +1,15 @@
+// Command dictator is the CLI entry point wrapping the calculator, store,
+// process, and log subsystems.
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
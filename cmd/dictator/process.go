@@ -0,0 +1,48 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/seuros/dictator/output"
+	"github.com/seuros/dictator/processor"
+)
+
+var processSteps []string
+
+var processCmd = &cobra.Command{
+	Use:   "process <input>",
+	Short: "Run input through a chain of string processors",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		procs := make([]processor.ProcessorFunc, 0, len(processSteps))
+		for _, step := range processSteps {
+			switch step {
+			case "upper":
+				procs = append(procs, processor.UppercaseProcessor)
+			case "lower":
+				procs = append(procs, processor.LowercaseProcessor)
+			case "trim":
+				procs = append(procs, processor.TrimProcessor)
+			default:
+				return fmt.Errorf("unknown processor %q: expected upper, lower, or trim", step)
+			}
+		}
+
+		result, err := processor.ChainProcessors(cmd.Context(), args[0], procs...)
+		if err != nil {
+			return err
+		}
+		return output.Write(os.Stdout, currentFormat(), map[string]string{
+			"input":  args[0],
+			"result": result,
+		})
+	},
+}
+
+func init() {
+	processCmd.Flags().StringSliceVar(&processSteps, "step", []string{"trim"}, "processors to apply in order: upper, lower, trim")
+	rootCmd.AddCommand(processCmd)
+}
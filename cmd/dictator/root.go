@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/seuros/dictator/output"
+)
+
+// config holds defaults loaded from --config, overridden by explicit flags.
+type config struct {
+	Output string `yaml:"output"`
+}
+
+var (
+	cfgFile      string
+	outputFormat string
+	cfg          config
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "dictator",
+	Short: "dictator is a small toolkit of calc, store, process, and log utilities",
+	// Runtime errors (a missing key, a division by zero) are user errors,
+	// not usage errors, so don't dump the usage block for them. main prints
+	// the error itself, so cobra shouldn't print its own copy too.
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		if err := loadConfig(); err != nil {
+			return err
+		}
+		if !cmd.Flags().Changed("output") && cfg.Output != "" {
+			outputFormat = cfg.Output
+		}
+		if _, err := output.ParseFormat(outputFormat); err != nil {
+			return err
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "path to a YAML config file of default flag values")
+	rootCmd.PersistentFlags().StringVar(&outputFormat, "output", string(output.Simple), "output format: csv, simple, table, tsv, yaml, json")
+}
+
+// loadConfig reads cfgFile, if set, into cfg.
+func loadConfig() error {
+	if cfgFile == "" {
+		return nil
+	}
+	data, err := os.ReadFile(cfgFile)
+	if err != nil {
+		return fmt.Errorf("reading config %s: %w", cfgFile, err)
+	}
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return fmt.Errorf("parsing config %s: %w", cfgFile, err)
+	}
+	return nil
+}
+
+// currentFormat returns the resolved --output format for subcommands.
+func currentFormat() output.Format {
+	f, err := output.ParseFormat(outputFormat)
+	if err != nil {
+		return output.Simple
+	}
+	return f
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/seuros/dictator/datastore"
+	_ "github.com/seuros/dictator/datastore/bolt"
+	_ "github.com/seuros/dictator/datastore/redis"
+	"github.com/seuros/dictator/output"
+)
+
+var storeDSN string
+
+var storeCmd = &cobra.Command{
+	Use:   "store",
+	Short: "Get, set, or delete a key in the configured data store",
+}
+
+var storeGetCmd = &cobra.Command{
+	Use:   "get <key>",
+	Short: "Get a value by key",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := openStore()
+		if err != nil {
+			return err
+		}
+		defer store.Close()
+
+		value, err := store.Get(args[0])
+		if err != nil {
+			return err
+		}
+		return output.Write(os.Stdout, currentFormat(), map[string]string{
+			"key":   args[0],
+			"value": fmt.Sprintf("%v", value),
+		})
+	},
+}
+
+var storeSetCmd = &cobra.Command{
+	Use:   "set <key> <value>",
+	Short: "Set a value by key",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := openStore()
+		if err != nil {
+			return err
+		}
+		defer store.Close()
+
+		if err := store.Set(args[0], args[1]); err != nil {
+			return err
+		}
+		return output.Write(os.Stdout, currentFormat(), map[string]string{
+			"key":   args[0],
+			"value": args[1],
+		})
+	},
+}
+
+var storeDeleteCmd = &cobra.Command{
+	Use:   "delete <key>",
+	Short: "Delete a key",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := openStore()
+		if err != nil {
+			return err
+		}
+		defer store.Close()
+
+		if err := store.Delete(args[0]); err != nil {
+			return err
+		}
+		return output.Write(os.Stdout, currentFormat(), map[string]string{
+			"key":     args[0],
+			"deleted": "true",
+		})
+	},
+}
+
+var storeListCmd = &cobra.Command{
+	Use:   "list [prefix]",
+	Short: "List keys with an optional prefix",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := openStore()
+		if err != nil {
+			return err
+		}
+		defer store.Close()
+
+		var prefix string
+		if len(args) == 1 {
+			prefix = args[0]
+		}
+		keys, err := store.List(prefix)
+		if err != nil {
+			return err
+		}
+		// output.Write sorts fields lexicographically, so the index must be
+		// zero-padded to a fixed width; otherwise "key[10]" sorts before
+		// "key[2]" and the printed keys land on the wrong index labels.
+		width := len(fmt.Sprintf("%d", max(len(keys)-1, 0)))
+		fields := make(map[string]string, len(keys))
+		for i, k := range keys {
+			fields[fmt.Sprintf("key[%0*d]", width, i)] = k
+		}
+		return output.Write(os.Stdout, currentFormat(), fields)
+	},
+}
+
+// openStore resolves --store into a concrete, driver-backed DataStore.
+// It defaults to an in-memory store, which starts empty on every
+// invocation since the CLI is not long-running.
+func openStore() (datastore.DataStore, error) {
+	return datastore.Open(storeDSN)
+}
+
+func init() {
+	storeCmd.PersistentFlags().StringVar(&storeDSN, "store", "memory://", "data store DSN, e.g. bolt:///var/lib/dictator.db or redis://localhost:6379/0")
+	storeCmd.AddCommand(storeGetCmd, storeSetCmd, storeDeleteCmd, storeListCmd)
+	rootCmd.AddCommand(storeCmd)
+}
@@ -0,0 +1,170 @@
+// Package bolt registers a BoltDB-backed datastore.DataStore driver under
+// the "bolt" scheme, e.g. "bolt:///var/lib/dictator.db".
+package bolt
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/seuros/dictator/datastore"
+)
+
+var bucketName = []byte("dictator")
+
+func init() {
+	datastore.Register("bolt", open)
+}
+
+// Store is a datastore.DataStore backed by a BoltDB file.
+type Store struct {
+	db *bolt.DB
+}
+
+func open(dsn string) (datastore.DataStore, error) {
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("bolt: invalid dsn %q: %w", dsn, err)
+	}
+	path := u.Path
+	if path == "" {
+		path = u.Opaque
+	}
+
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("bolt: opening %s: %w", path, err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(bucketName)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("bolt: creating bucket: %w", err)
+	}
+
+	return &Store{db: db}, nil
+}
+
+// Get retrieves a value.
+func (s *Store) Get(key string) (interface{}, error) {
+	var value interface{}
+	err := s.db.View(func(tx *bolt.Tx) error {
+		raw := tx.Bucket(bucketName).Get([]byte(key))
+		if raw == nil {
+			return fmt.Errorf("key not found")
+		}
+		return json.Unmarshal(raw, &value)
+	})
+	return value, err
+}
+
+// Set stores a value.
+func (s *Store) Set(key string, value interface{}) error {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("bolt: encoding value for %s: %w", key, err)
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucketName).Put([]byte(key), raw)
+	})
+}
+
+// Delete removes a value.
+func (s *Store) Delete(key string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucketName).Delete([]byte(key))
+	})
+}
+
+// List returns all keys with the given prefix.
+func (s *Store) List(prefix string) ([]string, error) {
+	var keys []string
+	err := s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(bucketName).Cursor()
+		p := []byte(prefix)
+		for k, _ := c.Seek(p); k != nil && bytes.HasPrefix(k, p); k, _ = c.Next() {
+			keys = append(keys, string(k))
+		}
+		return nil
+	})
+	return keys, err
+}
+
+// Batch applies ops atomically in a single BoltDB transaction.
+func (s *Store) Batch(ops []datastore.Op) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(bucketName)
+		for _, op := range ops {
+			switch op.Kind {
+			case datastore.OpSet:
+				raw, err := json.Marshal(op.Value)
+				if err != nil {
+					return fmt.Errorf("bolt: encoding value for %s: %w", op.Key, err)
+				}
+				if err := b.Put([]byte(op.Key), raw); err != nil {
+					return err
+				}
+			case datastore.OpDelete:
+				if err := b.Delete([]byte(op.Key)); err != nil {
+					return err
+				}
+			default:
+				return fmt.Errorf("bolt: unknown op kind %v", op.Kind)
+			}
+		}
+		return nil
+	})
+}
+
+// Close closes the underlying BoltDB file.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Transaction runs fn atomically within a single BoltDB read-write
+// transaction.
+func (s *Store) Transaction(fn func(tx datastore.Tx) error) error {
+	return s.db.Update(func(btx *bolt.Tx) error {
+		return fn(&boltTx{bucket: btx.Bucket(bucketName)})
+	})
+}
+
+type boltTx struct {
+	bucket *bolt.Bucket
+}
+
+func (t *boltTx) Get(key string) (interface{}, error) {
+	raw := t.bucket.Get([]byte(key))
+	if raw == nil {
+		return nil, fmt.Errorf("key not found")
+	}
+	var value interface{}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func (t *boltTx) Set(key string, value interface{}) error {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return t.bucket.Put([]byte(key), raw)
+}
+
+func (t *boltTx) Delete(key string) error {
+	return t.bucket.Delete([]byte(key))
+}
+
+var (
+	_ datastore.DataStore     = (*Store)(nil)
+	_ datastore.Transactional = (*Store)(nil)
+)
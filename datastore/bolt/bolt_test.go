@@ -0,0 +1,161 @@
+package bolt
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/seuros/dictator/datastore"
+)
+
+func openTestStore(t *testing.T) *Store {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "dictator.db")
+	s, err := open("bolt://" + path)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	store := s.(*Store)
+	t.Cleanup(func() { store.Close() })
+	return store
+}
+
+func TestStoreRoundTrip(t *testing.T) {
+	s := openTestStore(t)
+
+	if err := s.Set("key", "value"); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	v, err := s.Get("key")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if v != "value" {
+		t.Fatalf("Get = %v, want %q", v, "value")
+	}
+
+	if err := s.Delete("key"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := s.Get("key"); err == nil {
+		t.Fatalf("Get after Delete: want error, got nil")
+	}
+}
+
+func TestStoreRoundTripSurvivesReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dictator.db")
+
+	s1, err := open("bolt://" + path)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	if err := s1.(*Store).Set("key", "value"); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	if err := s1.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	s2, err := open("bolt://" + path)
+	if err != nil {
+		t.Fatalf("reopen: %v", err)
+	}
+	defer s2.Close()
+
+	v, err := s2.(*Store).Get("key")
+	if err != nil {
+		t.Fatalf("Get after reopen: %v", err)
+	}
+	if v != "value" {
+		t.Fatalf("Get after reopen = %v, want %q", v, "value")
+	}
+}
+
+func TestStoreList(t *testing.T) {
+	s := openTestStore(t)
+
+	for i := 0; i < 3; i++ {
+		if err := s.Set(fmt.Sprintf("a-%d", i), i); err != nil {
+			t.Fatalf("Set: %v", err)
+		}
+	}
+	if err := s.Set("b-0", 0); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	keys, err := s.List("a-")
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(keys) != 3 {
+		t.Fatalf("List(\"a-\") = %v, want 3 keys", keys)
+	}
+}
+
+func TestStoreBatchAppliesAllOps(t *testing.T) {
+	s := openTestStore(t)
+
+	if err := s.Set("existing", "old"); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	err := s.Batch([]datastore.Op{
+		{Kind: datastore.OpSet, Key: "existing", Value: "new"},
+		{Kind: datastore.OpSet, Key: "fresh", Value: "value"},
+		{Kind: datastore.OpDelete, Key: "existing"},
+	})
+	if err != nil {
+		t.Fatalf("Batch: %v", err)
+	}
+
+	if _, err := s.Get("existing"); err == nil {
+		t.Fatalf("Get(existing) after Batch delete: want error, got nil")
+	}
+	v, err := s.Get("fresh")
+	if err != nil {
+		t.Fatalf("Get(fresh): %v", err)
+	}
+	if v != "value" {
+		t.Fatalf("Get(fresh) = %v, want %q", v, "value")
+	}
+}
+
+func TestStoreTransactionRollsBackOnError(t *testing.T) {
+	s := openTestStore(t)
+
+	if err := s.Set("key", "original"); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	wantErr := fmt.Errorf("boom")
+	err := s.Transaction(func(tx datastore.Tx) error {
+		if err := tx.Set("key", "changed"); err != nil {
+			return err
+		}
+		return wantErr
+	})
+	if err != wantErr {
+		t.Fatalf("Transaction error = %v, want %v", err, wantErr)
+	}
+
+	v, err := s.Get("key")
+	if err != nil || v != "original" {
+		t.Fatalf("key = %v, %v; want \"original\", nil (rollback should have restored it)", v, err)
+	}
+}
+
+func TestStoreTransactionCommitsOnSuccess(t *testing.T) {
+	s := openTestStore(t)
+
+	err := s.Transaction(func(tx datastore.Tx) error {
+		return tx.Set("key", "value")
+	})
+	if err != nil {
+		t.Fatalf("Transaction: %v", err)
+	}
+
+	v, err := s.Get("key")
+	if err != nil || v != "value" {
+		t.Fatalf("key = %v, %v; want \"value\", nil", v, err)
+	}
+}
@@ -0,0 +1,49 @@
+// Package datastore provides a small key/value persistence interface with
+// pluggable backends selected by DSN.
+package datastore
+
+// DataStore is the persistence interface backing the store subcommand.
+type DataStore interface {
+	Get(key string) (interface{}, error)
+	Set(key string, value interface{}) error
+	Delete(key string) error
+	// List returns all keys with the given prefix.
+	List(prefix string) ([]string, error)
+	// Batch applies ops in order; backends that support atomic batches
+	// apply them as one unit, others simply apply them sequentially.
+	Batch(ops []Op) error
+	// Close releases any resources held by the backend.
+	Close() error
+}
+
+// OpKind identifies the kind of mutation a Op represents.
+type OpKind int
+
+// Supported Op kinds.
+const (
+	OpSet OpKind = iota
+	OpDelete
+)
+
+// Op is a single mutation to apply via Batch.
+type Op struct {
+	Kind  OpKind
+	Key   string
+	Value interface{}
+}
+
+// Transactional is implemented by backends that can apply multiple key
+// updates atomically.
+type Transactional interface {
+	DataStore
+	// Transaction runs fn with a Tx scoped to a single atomic update; if fn
+	// returns an error the transaction is rolled back.
+	Transaction(fn func(tx Tx) error) error
+}
+
+// Tx is the view of a DataStore available inside a Transaction callback.
+type Tx interface {
+	Get(key string) (interface{}, error)
+	Set(key string, value interface{}) error
+	Delete(key string) error
+}
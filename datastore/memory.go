@@ -0,0 +1,354 @@
+package datastore
+
+import (
+	"container/list"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const defaultJanitorInterval = time.Second
+
+// MemoryStoreOption configures a MemoryStore built via NewMemoryStore.
+type MemoryStoreOption func(*MemoryStore)
+
+// WithMaxEntries bounds MemoryStore to at most n entries, evicting the
+// least-recently-used entry once the bound is reached. 0 (the default)
+// means unbounded.
+func WithMaxEntries(n int) MemoryStoreOption {
+	return func(m *MemoryStore) { m.maxEntries = n }
+}
+
+// WithMaxBytes bounds MemoryStore to an approximate n bytes of key+value
+// data, evicting least-recently-used entries as needed. 0 (the default)
+// means unbounded.
+func WithMaxBytes(n int) MemoryStoreOption {
+	return func(m *MemoryStore) { m.maxBytes = n }
+}
+
+// WithJanitorInterval sets how often the background goroutine sweeps for
+// expired entries. Defaults to one second.
+func WithJanitorInterval(d time.Duration) MemoryStoreOption {
+	return func(m *MemoryStore) { m.janitorInterval = d }
+}
+
+// Stats reports MemoryStore cache behavior, suitable for exporting as
+// Prometheus-style counters/gauges.
+type Stats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+	Entries   int
+	Bytes     int
+}
+
+type memEntry struct {
+	key       string
+	value     interface{}
+	expiresAt time.Time // zero means no expiry
+	size      int
+	elem      *list.Element
+}
+
+// MemoryStore implements DataStore with an in-memory map. It is safe for
+// concurrent use, supports per-key TTL expiry via a background janitor, and
+// can bound its size with LRU eviction.
+type MemoryStore struct {
+	mu    sync.RWMutex
+	data  map[string]*memEntry
+	order *list.List // front = most recently used
+
+	maxEntries      int
+	maxBytes        int
+	curBytes        int
+	janitorInterval time.Duration
+
+	stats Stats
+
+	stopOnce sync.Once
+	stopCh   chan struct{}
+}
+
+// NewMemoryStore creates a new, empty MemoryStore and starts its janitor
+// goroutine. Callers must call Close when done to stop the janitor.
+func NewMemoryStore(opts ...MemoryStoreOption) *MemoryStore {
+	m := &MemoryStore{
+		data:            make(map[string]*memEntry),
+		order:           list.New(),
+		janitorInterval: defaultJanitorInterval,
+		stopCh:          make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	go m.janitor()
+	return m
+}
+
+// Get retrieves a value.
+func (m *MemoryStore) Get(key string) (interface{}, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, exists := m.data[key]
+	if !exists || m.expired(e) {
+		if exists {
+			m.removeLocked(e)
+		}
+		m.stats.Misses++
+		return nil, fmt.Errorf("key not found")
+	}
+
+	m.stats.Hits++
+	m.order.MoveToFront(e.elem)
+	return e.value, nil
+}
+
+// Set stores a value with no expiry.
+func (m *MemoryStore) Set(key string, value interface{}) error {
+	return m.SetWithTTL(key, value, 0)
+}
+
+// SetWithTTL stores a value that expires after ttl. A zero ttl means the
+// value never expires.
+func (m *MemoryStore) SetWithTTL(key string, value interface{}, ttl time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.setLocked(key, value, ttl)
+	return nil
+}
+
+func (m *MemoryStore) setLocked(key string, value interface{}, ttl time.Duration) {
+	size := len(key) + approxSize(value)
+
+	if e, exists := m.data[key]; exists {
+		m.curBytes -= e.size
+		e.value = value
+		e.size = size
+		if ttl > 0 {
+			e.expiresAt = time.Now().Add(ttl)
+		} else {
+			e.expiresAt = time.Time{}
+		}
+		m.curBytes += size
+		m.order.MoveToFront(e.elem)
+		m.evictLocked()
+		return
+	}
+
+	e := &memEntry{key: key, value: value, size: size}
+	if ttl > 0 {
+		e.expiresAt = time.Now().Add(ttl)
+	}
+	e.elem = m.order.PushFront(e)
+	m.data[key] = e
+	m.curBytes += size
+	m.evictLocked()
+}
+
+// Delete removes a value.
+func (m *MemoryStore) Delete(key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if e, exists := m.data[key]; exists {
+		m.removeLocked(e)
+	}
+	return nil
+}
+
+// List returns all keys with the given prefix.
+func (m *MemoryStore) List(prefix string) ([]string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	keys := make([]string, 0)
+	for k, e := range m.data {
+		if m.expired(e) {
+			continue
+		}
+		if len(prefix) == 0 || (len(k) >= len(prefix) && k[:len(prefix)] == prefix) {
+			keys = append(keys, k)
+		}
+	}
+	return keys, nil
+}
+
+// Batch applies ops as a single locked unit.
+func (m *MemoryStore) Batch(ops []Op) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, op := range ops {
+		switch op.Kind {
+		case OpSet:
+			m.setLocked(op.Key, op.Value, 0)
+		case OpDelete:
+			if e, exists := m.data[op.Key]; exists {
+				m.removeLocked(e)
+			}
+		default:
+			return fmt.Errorf("unknown op kind %v", op.Kind)
+		}
+	}
+	return nil
+}
+
+// Close stops the janitor goroutine. MemoryStore holds no other resources.
+func (m *MemoryStore) Close() error {
+	m.stopOnce.Do(func() { close(m.stopCh) })
+	return nil
+}
+
+// Transaction runs fn while holding m's write lock for the whole call, so
+// no other Get/Set/Delete/Batch/Transaction call can interleave with it.
+// If fn returns an error, every Set/Delete it made through tx is undone
+// before Transaction returns that error.
+func (m *MemoryStore) Transaction(fn func(tx Tx) error) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	tx := &memTx{store: m}
+	if err := fn(tx); err != nil {
+		tx.rollback()
+		return err
+	}
+	return nil
+}
+
+// memTx is the Tx MemoryStore.Transaction hands to fn. Its methods assume
+// m.mu is already held by the enclosing Transaction call, so they operate
+// on the store directly instead of through MemoryStore's own locked
+// Get/Set/Delete (which would deadlock re-acquiring the lock).
+type memTx struct {
+	store *MemoryStore
+	undo  []func()
+}
+
+func (t *memTx) Get(key string) (interface{}, error) {
+	m := t.store
+	e, exists := m.data[key]
+	if !exists || m.expired(e) {
+		if exists {
+			m.removeLocked(e)
+		}
+		m.stats.Misses++
+		return nil, fmt.Errorf("key not found")
+	}
+	m.stats.Hits++
+	m.order.MoveToFront(e.elem)
+	return e.value, nil
+}
+
+func (t *memTx) Set(key string, value interface{}) error {
+	m := t.store
+	prev, existed := m.data[key]
+	if existed {
+		prevValue := prev.value
+		t.undo = append(t.undo, func() { m.setLocked(key, prevValue, 0) })
+	} else {
+		t.undo = append(t.undo, func() {
+			if e, exists := m.data[key]; exists {
+				m.removeLocked(e)
+			}
+		})
+	}
+	m.setLocked(key, value, 0)
+	return nil
+}
+
+func (t *memTx) Delete(key string) error {
+	m := t.store
+	if e, exists := m.data[key]; exists {
+		prevValue := e.value
+		m.removeLocked(e)
+		t.undo = append(t.undo, func() { m.setLocked(key, prevValue, 0) })
+	}
+	return nil
+}
+
+// rollback undoes every Set/Delete made through t, most recent first.
+func (t *memTx) rollback() {
+	for i := len(t.undo) - 1; i >= 0; i-- {
+		t.undo[i]()
+	}
+}
+
+// Stats returns a snapshot of cache hit/miss/eviction counters and current
+// size.
+func (m *MemoryStore) Stats() Stats {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	s := m.stats
+	s.Entries = len(m.data)
+	s.Bytes = m.curBytes
+	return s
+}
+
+// expired reports whether e has a TTL that has passed. Callers must hold
+// m.mu.
+func (m *MemoryStore) expired(e *memEntry) bool {
+	return !e.expiresAt.IsZero() && time.Now().After(e.expiresAt)
+}
+
+// removeLocked removes e from the store. Callers must hold m.mu.
+func (m *MemoryStore) removeLocked(e *memEntry) {
+	delete(m.data, e.key)
+	m.order.Remove(e.elem)
+	m.curBytes -= e.size
+}
+
+// evictLocked removes least-recently-used entries until the store is
+// within its configured bounds. Callers must hold m.mu.
+func (m *MemoryStore) evictLocked() {
+	for (m.maxEntries > 0 && len(m.data) > m.maxEntries) || (m.maxBytes > 0 && m.curBytes > m.maxBytes) {
+		back := m.order.Back()
+		if back == nil {
+			return
+		}
+		e := back.Value.(*memEntry)
+		m.removeLocked(e)
+		m.stats.Evictions++
+	}
+}
+
+// janitor periodically sweeps expired entries until Close is called.
+func (m *MemoryStore) janitor() {
+	ticker := time.NewTicker(m.janitorInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-m.stopCh:
+			return
+		case <-ticker.C:
+			m.sweep()
+		}
+	}
+}
+
+func (m *MemoryStore) sweep() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	for _, e := range m.data {
+		if !e.expiresAt.IsZero() && now.After(e.expiresAt) {
+			m.removeLocked(e)
+		}
+	}
+}
+
+// approxSize estimates the in-memory size of value for MaxBytes accounting.
+// It is a rough heuristic, not an exact measurement.
+func approxSize(value interface{}) int {
+	switch v := value.(type) {
+	case string:
+		return len(v)
+	case []byte:
+		return len(v)
+	default:
+		return len(fmt.Sprintf("%v", v))
+	}
+}
+
+var _ Transactional = (*MemoryStore)(nil)
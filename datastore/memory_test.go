@@ -0,0 +1,126 @@
+package datastore
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestMemoryStoreTransactionAtomicAgainstConcurrentWriters(t *testing.T) {
+	m := NewMemoryStore()
+	defer m.Close()
+
+	const n = 200
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+
+	// A transaction that reads "counter", then (after yielding to let
+	// concurrent writers race in) writes back counter+1. If Transaction
+	// doesn't hold the lock for its whole duration, a concurrent Set can
+	// slip in between the Get and the Set below and get clobbered.
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		<-start
+		err := m.Transaction(func(tx Tx) error {
+			v, _ := tx.Get("counter")
+			n, _ := v.(int)
+			time.Sleep(time.Millisecond)
+			return tx.Set("counter", n+1)
+		})
+		if err != nil {
+			t.Errorf("Transaction: %v", err)
+		}
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		<-start
+		for i := 0; i < n; i++ {
+			_ = m.Set(fmt.Sprintf("other-%d", i), i)
+		}
+	}()
+
+	close(start)
+	wg.Wait()
+
+	v, err := m.Get("counter")
+	if err != nil {
+		t.Fatalf("Get(counter): %v", err)
+	}
+	if v.(int) != 1 {
+		t.Fatalf("counter = %v, want 1 (transaction should have been atomic)", v)
+	}
+}
+
+func TestMemoryStoreBatchAppliesAllOps(t *testing.T) {
+	m := NewMemoryStore()
+	defer m.Close()
+
+	if err := m.Set("existing", "old"); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	err := m.Batch([]Op{
+		{Kind: OpSet, Key: "existing", Value: "new"},
+		{Kind: OpSet, Key: "fresh", Value: "value"},
+		{Kind: OpDelete, Key: "existing"},
+	})
+	if err != nil {
+		t.Fatalf("Batch: %v", err)
+	}
+
+	if _, err := m.Get("existing"); err == nil {
+		t.Fatalf("Get(existing) after Batch delete: want error, got nil")
+	}
+	v, err := m.Get("fresh")
+	if err != nil {
+		t.Fatalf("Get(fresh): %v", err)
+	}
+	if v != "value" {
+		t.Fatalf("Get(fresh) = %v, want %q", v, "value")
+	}
+}
+
+func TestMemoryStoreBatchRejectsUnknownOpKind(t *testing.T) {
+	m := NewMemoryStore()
+	defer m.Close()
+
+	err := m.Batch([]Op{{Kind: OpKind(99), Key: "key"}})
+	if err == nil {
+		t.Fatalf("Batch with unknown op kind: want error, got nil")
+	}
+}
+
+func TestMemoryStoreTransactionRollsBackOnError(t *testing.T) {
+	m := NewMemoryStore()
+	defer m.Close()
+
+	if err := m.Set("key", "original"); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	wantErr := fmt.Errorf("boom")
+	err := m.Transaction(func(tx Tx) error {
+		if err := tx.Set("key", "changed"); err != nil {
+			return err
+		}
+		if err := tx.Set("new-key", "value"); err != nil {
+			return err
+		}
+		return wantErr
+	})
+	if err != wantErr {
+		t.Fatalf("Transaction error = %v, want %v", err, wantErr)
+	}
+
+	v, err := m.Get("key")
+	if err != nil || v != "original" {
+		t.Fatalf("key = %v, %v; want \"original\", nil (rollback should have restored it)", v, err)
+	}
+	if _, err := m.Get("new-key"); err == nil {
+		t.Fatalf("new-key exists after rollback, want it to have been undone")
+	}
+}
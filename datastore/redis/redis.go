@@ -0,0 +1,101 @@
+// Package redis registers a Redis-backed datastore.DataStore driver under
+// the "redis" scheme, e.g. "redis://localhost:6379/0".
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	goredis "github.com/redis/go-redis/v9"
+
+	"github.com/seuros/dictator/datastore"
+)
+
+func init() {
+	datastore.Register("redis", open)
+}
+
+// Store is a datastore.DataStore backed by Redis.
+type Store struct {
+	client *goredis.Client
+}
+
+func open(dsn string) (datastore.DataStore, error) {
+	opts, err := goredis.ParseURL(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("redis: invalid dsn %q: %w", dsn, err)
+	}
+	return &Store{client: goredis.NewClient(opts)}, nil
+}
+
+// Get retrieves a value.
+func (s *Store) Get(key string) (interface{}, error) {
+	raw, err := s.client.Get(context.Background(), key).Bytes()
+	if err == goredis.Nil {
+		return nil, fmt.Errorf("key not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// Set stores a value.
+func (s *Store) Set(key string, value interface{}) error {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("redis: encoding value for %s: %w", key, err)
+	}
+	return s.client.Set(context.Background(), key, raw, 0).Err()
+}
+
+// Delete removes a value.
+func (s *Store) Delete(key string) error {
+	return s.client.Del(context.Background(), key).Err()
+}
+
+// List returns all keys with the given prefix.
+func (s *Store) List(prefix string) ([]string, error) {
+	var keys []string
+	iter := s.client.Scan(context.Background(), 0, prefix+"*", 0).Iterator()
+	for iter.Next(context.Background()) {
+		keys = append(keys, iter.Val())
+	}
+	return keys, iter.Err()
+}
+
+// Batch applies ops as a single Redis pipeline. Redis pipelines are not
+// atomic across unrelated keys the way a BoltDB transaction is; callers
+// needing atomicity should use a Lua script or MULTI/WATCH directly.
+func (s *Store) Batch(ops []datastore.Op) error {
+	ctx := context.Background()
+	pipe := s.client.Pipeline()
+	for _, op := range ops {
+		switch op.Kind {
+		case datastore.OpSet:
+			raw, err := json.Marshal(op.Value)
+			if err != nil {
+				return fmt.Errorf("redis: encoding value for %s: %w", op.Key, err)
+			}
+			pipe.Set(ctx, op.Key, raw, 0)
+		case datastore.OpDelete:
+			pipe.Del(ctx, op.Key)
+		default:
+			return fmt.Errorf("redis: unknown op kind %v", op.Kind)
+		}
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// Close closes the underlying Redis client.
+func (s *Store) Close() error {
+	return s.client.Close()
+}
+
+var _ datastore.DataStore = (*Store)(nil)
@@ -0,0 +1,53 @@
+package datastore
+
+import (
+	"fmt"
+	"net/url"
+	"sync"
+)
+
+// Factory builds a DataStore from a DSN, e.g. "bolt:///var/lib/dictator.db"
+// or "redis://localhost:6379/0".
+type Factory func(dsn string) (DataStore, error)
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]Factory{
+		"memory": func(string) (DataStore, error) {
+			return NewMemoryStore(), nil
+		},
+	}
+)
+
+// Register adds a backend factory under name, which is matched against a
+// DSN's scheme by Open. Register panics if name is already registered, in
+// the spirit of database/sql's driver registration.
+func Register(name string, factory Factory) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if _, exists := registry[name]; exists {
+		panic(fmt.Sprintf("datastore: Register called twice for driver %q", name))
+	}
+	registry[name] = factory
+}
+
+// Open parses dsn's scheme and dispatches to the matching registered
+// factory. A bare "memory" DSN (or an empty one) opens a MemoryStore.
+func Open(dsn string) (DataStore, error) {
+	if dsn == "" || dsn == "memory" {
+		dsn = "memory://"
+	}
+
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("datastore: invalid dsn %q: %w", dsn, err)
+	}
+
+	registryMu.RLock()
+	factory, ok := registry[u.Scheme]
+	registryMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("datastore: unknown driver %q", u.Scheme)
+	}
+	return factory(dsn)
+}
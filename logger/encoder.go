@@ -0,0 +1,76 @@
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Encoding names a built-in Encoder.
+type Encoding string
+
+// Supported encodings.
+const (
+	TextEncoding   Encoding = "text"
+	JSONEncoding   Encoding = "json"
+	LogfmtEncoding Encoding = "logfmt"
+)
+
+// Encoder renders an Entry to bytes for a Sink to write.
+type Encoder interface {
+	Encode(e Entry) ([]byte, error)
+}
+
+// NewEncoder returns the built-in Encoder for enc, defaulting to TextEncoding
+// for unrecognized values.
+func NewEncoder(enc Encoding) Encoder {
+	switch enc {
+	case JSONEncoding:
+		return jsonEncoder{}
+	case LogfmtEncoding:
+		return logfmtEncoder{}
+	default:
+		return textEncoder{}
+	}
+}
+
+type textEncoder struct{}
+
+func (textEncoder) Encode(e Entry) ([]byte, error) {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s [%s] %s", e.Time.Format("2006-01-02T15:04:05.000Z07:00"), strings.ToUpper(e.Level.String()), e.Message)
+	for _, f := range e.Fields {
+		fmt.Fprintf(&sb, " %s=%v", f.Key, f.Value)
+	}
+	sb.WriteByte('\n')
+	return []byte(sb.String()), nil
+}
+
+type logfmtEncoder struct{}
+
+func (logfmtEncoder) Encode(e Entry) ([]byte, error) {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "time=%q level=%s msg=%q", e.Time.Format("2006-01-02T15:04:05.000Z07:00"), e.Level, e.Message)
+	for _, f := range e.Fields {
+		fmt.Fprintf(&sb, " %s=%q", f.Key, fmt.Sprintf("%v", f.Value))
+	}
+	sb.WriteByte('\n')
+	return []byte(sb.String()), nil
+}
+
+type jsonEncoder struct{}
+
+func (jsonEncoder) Encode(e Entry) ([]byte, error) {
+	m := make(map[string]interface{}, len(e.Fields)+3)
+	m["time"] = e.Time.Format("2006-01-02T15:04:05.000Z07:00")
+	m["level"] = e.Level.String()
+	m["msg"] = e.Message
+	for _, f := range e.Fields {
+		m[f.Key] = f.Value
+	}
+	raw, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	return append(raw, '\n'), nil
+}
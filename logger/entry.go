@@ -0,0 +1,17 @@
+package logger
+
+import "time"
+
+// Field is a single structured key-value pair attached to an Entry.
+type Field struct {
+	Key   string
+	Value interface{}
+}
+
+// Entry is a single log record passed to an Encoder.
+type Entry struct {
+	Time    time.Time
+	Level   Level
+	Message string
+	Fields  []Field
+}
@@ -0,0 +1,49 @@
+package logger
+
+// Level is a logging severity, ordered from least to most severe.
+type Level int
+
+// Supported levels.
+const (
+	Debug Level = iota
+	Info
+	Warn
+	Error
+	Fatal
+)
+
+// String implements fmt.Stringer.
+func (l Level) String() string {
+	switch l {
+	case Debug:
+		return "debug"
+	case Info:
+		return "info"
+	case Warn:
+		return "warn"
+	case Error:
+		return "error"
+	case Fatal:
+		return "fatal"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseLevel parses a level name, defaulting to Info for unrecognized input.
+func ParseLevel(s string) Level {
+	switch s {
+	case "debug":
+		return Debug
+	case "info":
+		return Info
+	case "warn", "warning":
+		return Warn
+	case "error":
+		return Error
+	case "fatal":
+		return Fatal
+	default:
+		return Info
+	}
+}
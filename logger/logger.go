@@ -0,0 +1,190 @@
+// Package logger provides a structured, leveled logger with pluggable
+// encoders and sinks, plus a LoggerInterface compatibility shim for callers
+// written against the older Log/Error/Warning API.
+package logger
+
+import (
+	"os"
+	"sync"
+	"time"
+)
+
+// LoggerInterface defines the original, unstructured logging behavior.
+// New code should prefer Logger's Debug/Info/Warn/Error/Fatal methods;
+// LoggerInterface exists so old callers keep compiling.
+type LoggerInterface interface {
+	Log(message string)
+	Error(err error)
+	Warning(message string)
+}
+
+// LoggerConfig configures a Logger.
+type LoggerConfig struct {
+	// Level is the minimum level that will be emitted.
+	Level Level
+	// Encoding selects how entries are rendered; defaults to TextEncoding.
+	Encoding Encoding
+	// Sinks receives every encoded entry; defaults to [StdoutSink()].
+	Sinks []Sink
+	// Sampling, if set, thins repeated Warn/Error/Fatal messages.
+	Sampling *SamplingConfig
+	// RateLimit, if set, caps entries per second for Warn/Error/Fatal.
+	RateLimit *RateLimitConfig
+}
+
+// Logger is a structured, leveled logger.
+type Logger struct {
+	level   Level
+	encoder Encoder
+	sinks   []Sink
+	fields  []Field
+
+	mu      sync.Mutex
+	sampler *sampler
+	limiter *rateLimiter
+}
+
+// NewLogger builds a Logger from cfg.
+func NewLogger(cfg LoggerConfig) *Logger {
+	sinks := cfg.Sinks
+	if len(sinks) == 0 {
+		sinks = []Sink{StdoutSink()}
+	}
+
+	l := &Logger{
+		level:   cfg.Level,
+		encoder: NewEncoder(cfg.Encoding),
+		sinks:   sinks,
+	}
+	if cfg.Sampling != nil {
+		l.sampler = newSampler(*cfg.Sampling)
+	}
+	if cfg.RateLimit != nil {
+		l.limiter = newRateLimiter(*cfg.RateLimit)
+	}
+	return l
+}
+
+// With returns a child Logger that attaches the given key-value fields to
+// every entry it emits, in addition to this Logger's fields.
+func (l *Logger) With(kv ...interface{}) *Logger {
+	child := &Logger{
+		level:   l.level,
+		encoder: l.encoder,
+		sinks:   l.sinks,
+		sampler: l.sampler,
+		limiter: l.limiter,
+		fields:  append(append([]Field(nil), l.fields...), fieldsFromKV(kv)...),
+	}
+	return child
+}
+
+func fieldsFromKV(kv []interface{}) []Field {
+	fields := make([]Field, 0, len(kv)/2)
+	for i := 0; i+1 < len(kv); i += 2 {
+		key, ok := kv[i].(string)
+		if !ok {
+			continue
+		}
+		fields = append(fields, Field{Key: key, Value: kv[i+1]})
+	}
+	return fields
+}
+
+// Debug logs at Debug level.
+func (l *Logger) Debug(message string, kv ...interface{}) { l.log(Debug, message, kv) }
+
+// Info logs at Info level.
+func (l *Logger) Info(message string, kv ...interface{}) { l.log(Info, message, kv) }
+
+// Warn logs at Warn level, subject to sampling/rate limiting.
+func (l *Logger) Warn(message string, kv ...interface{}) { l.log(Warn, message, kv) }
+
+// Error logs at Error level, subject to sampling/rate limiting.
+func (l *Logger) Error(message string, kv ...interface{}) { l.log(Error, message, kv) }
+
+// Fatal logs at Fatal level, subject to sampling/rate limiting, then exits
+// the process with status 1.
+func (l *Logger) Fatal(message string, kv ...interface{}) {
+	l.log(Fatal, message, kv)
+	os.Exit(1)
+}
+
+func (l *Logger) log(level Level, message string, kv []interface{}) {
+	if level < l.level {
+		return
+	}
+	if level >= Warn {
+		if l.sampler != nil && !l.sampler.allow(message) {
+			return
+		}
+		if l.limiter != nil && !l.limiter.allow() {
+			return
+		}
+	}
+
+	entry := Entry{
+		Time:    time.Now(),
+		Level:   level,
+		Message: message,
+		Fields:  append(append([]Field(nil), l.fields...), fieldsFromKV(kv)...),
+	}
+
+	raw, err := l.encoder.Encode(entry)
+	if err != nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, sink := range l.sinks {
+		_, _ = sink.Write(raw)
+	}
+}
+
+// Log implements LoggerInterface by logging message at Info level.
+func (l *Logger) Log(message string) { l.Info(message) }
+
+// Warning implements LoggerInterface by logging message at Warn level.
+func (l *Logger) Warning(message string) { l.Warn(message) }
+
+var _ LoggerInterface = (*loggerCompat)(nil)
+
+// loggerCompat satisfies LoggerInterface's Error(err error) signature, which
+// Logger.Error cannot implement directly since it already takes a message
+// string plus fields.
+type loggerCompat struct {
+	*Logger
+}
+
+func (c *loggerCompat) Error(err error) { c.Logger.Error(err.Error()) }
+
+// AsLoggerInterface adapts l to LoggerInterface for callers that still
+// depend on the old Log/Error/Warning signatures.
+func AsLoggerInterface(l *Logger) LoggerInterface {
+	return &loggerCompat{Logger: l}
+}
+
+// SimpleLogger is a minimal LoggerInterface implementation kept for
+// callers that want stdout logging without building a LoggerConfig.
+// Prefer NewLogger for anything else.
+type SimpleLogger struct {
+	Timestamp time.Time
+}
+
+// Log implementation.
+func (l *SimpleLogger) Log(message string) {
+	NewLogger(LoggerConfig{}).Info(message)
+}
+
+// Error implementation.
+func (l *SimpleLogger) Error(err error) {
+	NewLogger(LoggerConfig{}).Error(err.Error())
+}
+
+// Warning implementation.
+func (l *SimpleLogger) Warning(message string) {
+	NewLogger(LoggerConfig{}).Warn(message)
+}
+
+var _ LoggerInterface = (*SimpleLogger)(nil)
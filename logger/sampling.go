@@ -0,0 +1,84 @@
+package logger
+
+import (
+	"sync"
+	"time"
+)
+
+// SamplingConfig thins out repeated log lines at a given level so a hot loop
+// logging the same Warning/Error doesn't flood a sink. After First entries
+// with the same message in a Tick window, only every Thereafter-th entry is
+// let through. Thereafter <= 0 drops every entry past First for the rest of
+// the tick window.
+type SamplingConfig struct {
+	First      int
+	Thereafter int
+	Tick       time.Duration
+}
+
+// sampler tracks per-message counts within the current tick window.
+type sampler struct {
+	cfg    SamplingConfig
+	mu     sync.Mutex
+	counts map[string]int
+	reset  time.Time
+}
+
+func newSampler(cfg SamplingConfig) *sampler {
+	return &sampler{cfg: cfg, counts: make(map[string]int), reset: time.Now().Add(cfg.Tick)}
+}
+
+// allow reports whether an entry with the given message should be emitted.
+func (s *sampler) allow(message string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if time.Now().After(s.reset) {
+		s.counts = make(map[string]int)
+		s.reset = time.Now().Add(s.cfg.Tick)
+	}
+
+	s.counts[message]++
+	n := s.counts[message]
+	if n <= s.cfg.First {
+		return true
+	}
+	if s.cfg.Thereafter <= 0 {
+		return false
+	}
+	extra := n - s.cfg.First
+	return extra%s.cfg.Thereafter == 0
+}
+
+// RateLimitConfig caps the number of entries per-level let through per
+// second, dropping the rest.
+type RateLimitConfig struct {
+	PerSecond int
+}
+
+// rateLimiter is a simple per-second token bucket.
+type rateLimiter struct {
+	limit  int
+	mu     sync.Mutex
+	count  int
+	window time.Time
+}
+
+func newRateLimiter(cfg RateLimitConfig) *rateLimiter {
+	return &rateLimiter{limit: cfg.PerSecond, window: time.Now().Add(time.Second)}
+}
+
+func (r *rateLimiter) allow() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if time.Now().After(r.window) {
+		r.count = 0
+		r.window = time.Now().Add(time.Second)
+	}
+	if r.count >= r.limit {
+		return false
+	}
+	r.count++
+	return true
+}
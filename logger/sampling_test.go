@@ -0,0 +1,88 @@
+package logger
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSamplerThereafterZeroDropsAfterFirst(t *testing.T) {
+	s := newSampler(SamplingConfig{First: 2, Thereafter: 0, Tick: time.Hour})
+
+	if !s.allow("msg") || !s.allow("msg") {
+		t.Fatalf("first 2 entries should be allowed")
+	}
+	for i := 0; i < 5; i++ {
+		if s.allow("msg") {
+			t.Fatalf("entry %d past First should be dropped when Thereafter <= 0", i)
+		}
+	}
+}
+
+func TestSamplerThereafterNegativeDropsAfterFirst(t *testing.T) {
+	s := newSampler(SamplingConfig{First: 1, Thereafter: -1, Tick: time.Hour})
+
+	if !s.allow("msg") {
+		t.Fatalf("first entry should be allowed")
+	}
+	if s.allow("msg") {
+		t.Fatalf("entry past First should be dropped when Thereafter < 0")
+	}
+}
+
+func TestSamplerLetsEveryNthThroughAfterFirst(t *testing.T) {
+	s := newSampler(SamplingConfig{First: 1, Thereafter: 3, Tick: time.Hour})
+
+	var got []bool
+	for i := 0; i < 7; i++ {
+		got = append(got, s.allow("msg"))
+	}
+	want := []bool{true, false, false, true, false, false, true}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("allow() sequence = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestSamplerTracksMessagesIndependently(t *testing.T) {
+	s := newSampler(SamplingConfig{First: 1, Thereafter: 0, Tick: time.Hour})
+
+	if !s.allow("a") || !s.allow("b") {
+		t.Fatalf("first occurrence of each distinct message should be allowed")
+	}
+	if s.allow("a") || s.allow("b") {
+		t.Fatalf("second occurrence of either message should be dropped")
+	}
+}
+
+func TestSamplerResetsCountsAfterTick(t *testing.T) {
+	s := newSampler(SamplingConfig{First: 1, Thereafter: 0, Tick: time.Millisecond})
+
+	if !s.allow("msg") {
+		t.Fatalf("first entry should be allowed")
+	}
+	time.Sleep(5 * time.Millisecond)
+	if !s.allow("msg") {
+		t.Fatalf("entry after the tick window resets should be allowed again")
+	}
+}
+
+func TestRateLimiterAllowsUpToPerSecond(t *testing.T) {
+	r := newRateLimiter(RateLimitConfig{PerSecond: 2})
+
+	if !r.allow() || !r.allow() {
+		t.Fatalf("first PerSecond entries should be allowed")
+	}
+	if r.allow() {
+		t.Fatalf("entry beyond PerSecond should be dropped")
+	}
+}
+
+func TestRateLimiterResetsAfterWindow(t *testing.T) {
+	r := newRateLimiter(RateLimitConfig{PerSecond: 1})
+	r.window = time.Now().Add(-time.Millisecond)
+
+	if !r.allow() {
+		t.Fatalf("entry after the window has elapsed should be allowed")
+	}
+}
@@ -0,0 +1,55 @@
+package logger
+
+import (
+	"io"
+	"os"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// Sink is a destination for encoded log lines.
+type Sink interface {
+	io.Writer
+}
+
+// StdoutSink writes to os.Stdout.
+func StdoutSink() Sink {
+	return os.Stdout
+}
+
+// StderrSink writes to os.Stderr.
+func StderrSink() Sink {
+	return os.Stderr
+}
+
+// FileSinkConfig configures a rotating file Sink.
+type FileSinkConfig struct {
+	// Path is the log file to write to.
+	Path string
+	// MaxSizeMB is the size in megabytes a log file can reach before it is
+	// rotated. Defaults to 100.
+	MaxSizeMB int
+	// MaxBackups is the number of rotated files to keep. 0 keeps all.
+	MaxBackups int
+	// MaxAgeDays is the number of days to retain rotated files. 0 disables
+	// age-based cleanup.
+	MaxAgeDays int
+	// Compress gzip-compresses rotated files.
+	Compress bool
+}
+
+// NewFileSink returns a Sink that writes to cfg.Path, rotating it according
+// to cfg.
+func NewFileSink(cfg FileSinkConfig) Sink {
+	maxSize := cfg.MaxSizeMB
+	if maxSize == 0 {
+		maxSize = 100
+	}
+	return &lumberjack.Logger{
+		Filename:   cfg.Path,
+		MaxSize:    maxSize,
+		MaxBackups: cfg.MaxBackups,
+		MaxAge:     cfg.MaxAgeDays,
+		Compress:   cfg.Compress,
+	}
+}
@@ -0,0 +1,19 @@
+//go:build !windows
+
+package logger
+
+import (
+	"fmt"
+	"log/syslog"
+)
+
+// NewSyslogSink returns a Sink that forwards lines to the local syslog
+// daemon under tag, using priority as the default severity. Not available
+// on windows.
+func NewSyslogSink(tag string, priority syslog.Priority) (Sink, error) {
+	w, err := syslog.New(priority, tag)
+	if err != nil {
+		return nil, fmt.Errorf("logger: connecting to syslog: %w", err)
+	}
+	return w, nil
+}
@@ -0,0 +1,102 @@
+// Package output renders command results in the format requested via the
+// CLI's global --output flag.
+package output
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Format identifies one of the supported rendering formats.
+type Format string
+
+// Supported output formats.
+const (
+	Simple Format = "simple"
+	Table  Format = "table"
+	CSV    Format = "csv"
+	TSV    Format = "tsv"
+	YAML   Format = "yaml"
+	JSON   Format = "json"
+)
+
+// ParseFormat validates a --output flag value.
+func ParseFormat(s string) (Format, error) {
+	switch Format(s) {
+	case Simple, Table, CSV, TSV, YAML, JSON:
+		return Format(s), nil
+	default:
+		return "", fmt.Errorf("unknown output format %q", s)
+	}
+}
+
+// Write renders fields (an ordered key/value result) to w in the given format.
+func Write(w io.Writer, format Format, fields map[string]string) error {
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	switch format {
+	case Simple, "":
+		for _, k := range keys {
+			fmt.Fprintf(w, "%s: %s\n", k, fields[k])
+		}
+		return nil
+	case Table:
+		width := 0
+		for _, k := range keys {
+			if len(k) > width {
+				width = len(k)
+			}
+		}
+		for _, k := range keys {
+			fmt.Fprintf(w, "%-*s  %s\n", width, k, fields[k])
+		}
+		return nil
+	case CSV, TSV:
+		sep := ','
+		if format == TSV {
+			sep = '\t'
+		}
+		cw := csv.NewWriter(w)
+		cw.Comma = sep
+		if err := cw.Write(keys); err != nil {
+			return err
+		}
+		values := make([]string, len(keys))
+		for i, k := range keys {
+			values[i] = fields[k]
+		}
+		if err := cw.Write(values); err != nil {
+			return err
+		}
+		cw.Flush()
+		return cw.Error()
+	case YAML:
+		enc := yaml.NewEncoder(w)
+		defer enc.Close()
+		return enc.Encode(fields)
+	case JSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(fields)
+	default:
+		return fmt.Errorf("unknown output format %q", format)
+	}
+}
+
+// String renders fields to a string, discarding any write error (used by
+// callers that already validated format with ParseFormat).
+func String(format Format, fields map[string]string) string {
+	var sb strings.Builder
+	_ = Write(&sb, format, fields)
+	return sb.String()
+}
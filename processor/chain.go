@@ -0,0 +1,31 @@
+package processor
+
+import "context"
+
+// Chain builds a ProcessorFunc pipeline from processors and middleware.
+type Chain struct {
+	processors []ProcessorFunc
+	middleware []Middleware
+}
+
+// NewChain starts a Chain with the given processors, run in order.
+func NewChain(processors ...ProcessorFunc) *Chain {
+	return &Chain{processors: processors}
+}
+
+// Use appends middleware, applied to every processor in the chain in the
+// order added (the first Use call wraps outermost).
+func (c *Chain) Use(middleware ...Middleware) *Chain {
+	c.middleware = append(c.middleware, middleware...)
+	return c
+}
+
+// Run executes the chain against input, short-circuiting on the first
+// error and respecting ctx cancellation between processors.
+func (c *Chain) Run(ctx context.Context, input string) (string, error) {
+	wrapped := make([]ProcessorFunc, len(c.processors))
+	for i, p := range c.processors {
+		wrapped[i] = Wrap(p, c.middleware...)
+	}
+	return ChainProcessors(ctx, input, wrapped...)
+}
@@ -0,0 +1,92 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Middleware wraps a ProcessorFunc to add cross-cutting behavior such as
+// logging, timing, retry, or panic recovery.
+type Middleware func(next ProcessorFunc) ProcessorFunc
+
+// Wrap applies middleware to p, in the order given: the first middleware is
+// outermost.
+func Wrap(p ProcessorFunc, middleware ...Middleware) ProcessorFunc {
+	for i := len(middleware) - 1; i >= 0; i-- {
+		p = middleware[i](p)
+	}
+	return p
+}
+
+// WithLogging logs the input and output (or error) of every processor call
+// via log.
+func WithLogging(name string, log func(format string, args ...interface{})) Middleware {
+	return func(next ProcessorFunc) ProcessorFunc {
+		return func(ctx context.Context, s string) (string, error) {
+			out, err := next(ctx, s)
+			if err != nil {
+				log("%s: %q -> error: %v", name, s, err)
+			} else {
+				log("%s: %q -> %q", name, s, out)
+			}
+			return out, err
+		}
+	}
+}
+
+// WithTiming reports how long each call to the wrapped processor took via
+// observe.
+func WithTiming(observe func(d time.Duration)) Middleware {
+	return func(next ProcessorFunc) ProcessorFunc {
+		return func(ctx context.Context, s string) (string, error) {
+			start := time.Now()
+			out, err := next(ctx, s)
+			observe(time.Since(start))
+			return out, err
+		}
+	}
+}
+
+// WithRetry retries a failing processor up to attempts times total, waiting
+// baseDelay*2^n between attempts (exponential backoff), and gives up early
+// if ctx is done.
+func WithRetry(attempts int, baseDelay time.Duration) Middleware {
+	return func(next ProcessorFunc) ProcessorFunc {
+		return func(ctx context.Context, s string) (string, error) {
+			var out string
+			var err error
+			for attempt := 0; attempt < attempts; attempt++ {
+				out, err = next(ctx, s)
+				if err == nil {
+					return out, nil
+				}
+				if attempt == attempts-1 {
+					break
+				}
+				delay := baseDelay * time.Duration(1<<uint(attempt))
+				select {
+				case <-ctx.Done():
+					return out, ctx.Err()
+				case <-time.After(delay):
+				}
+			}
+			return out, err
+		}
+	}
+}
+
+// WithRecover converts a panic in the wrapped processor into an error
+// instead of crashing the process.
+func WithRecover() Middleware {
+	return func(next ProcessorFunc) ProcessorFunc {
+		return func(ctx context.Context, s string) (out string, err error) {
+			defer func() {
+				if r := recover(); r != nil {
+					err = fmt.Errorf("processor panic: %v", r)
+				}
+			}()
+			return next(ctx, s)
+		}
+	}
+}
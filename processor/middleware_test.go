@@ -0,0 +1,141 @@
+package processor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestWrapAppliesMiddlewareOutermostFirst(t *testing.T) {
+	var order []string
+	mark := func(name string) Middleware {
+		return func(next ProcessorFunc) ProcessorFunc {
+			return func(ctx context.Context, s string) (string, error) {
+				order = append(order, name)
+				return next(ctx, s)
+			}
+		}
+	}
+	base := func(ctx context.Context, s string) (string, error) {
+		order = append(order, "base")
+		return s, nil
+	}
+
+	p := Wrap(base, mark("first"), mark("second"))
+	if _, err := p(context.Background(), "x"); err != nil {
+		t.Fatalf("Wrap: %v", err)
+	}
+
+	want := []string{"first", "second", "base"}
+	if len(order) != len(want) {
+		t.Fatalf("call order = %v, want %v", order, want)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Fatalf("call order = %v, want %v", order, want)
+		}
+	}
+}
+
+func TestWithRetrySucceedsBeforeExhaustingAttempts(t *testing.T) {
+	calls := 0
+	flaky := func(ctx context.Context, s string) (string, error) {
+		calls++
+		if calls < 3 {
+			return "", errors.New("not yet")
+		}
+		return "ok", nil
+	}
+
+	p := Wrap(flaky, WithRetry(5, time.Microsecond))
+	out, err := p(context.Background(), "x")
+	if err != nil {
+		t.Fatalf("WithRetry: %v", err)
+	}
+	if out != "ok" || calls != 3 {
+		t.Fatalf("WithRetry: out=%q calls=%d, want ok/3", out, calls)
+	}
+}
+
+func TestWithRetryGivesUpAfterAttempts(t *testing.T) {
+	wantErr := errors.New("always fails")
+	calls := 0
+	alwaysFails := func(ctx context.Context, s string) (string, error) {
+		calls++
+		return "", wantErr
+	}
+
+	p := Wrap(alwaysFails, WithRetry(3, time.Microsecond))
+	_, err := p(context.Background(), "x")
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("WithRetry error = %v, want %v", err, wantErr)
+	}
+	if calls != 3 {
+		t.Fatalf("WithRetry made %d calls, want 3", calls)
+	}
+}
+
+func TestWithRetryStopsOnContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	alwaysFails := func(ctx context.Context, s string) (string, error) {
+		calls++
+		cancel()
+		return "", errors.New("fails")
+	}
+
+	p := Wrap(alwaysFails, WithRetry(5, time.Hour))
+	_, err := p(ctx, "x")
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("WithRetry error = %v, want context.Canceled", err)
+	}
+	if calls != 1 {
+		t.Fatalf("WithRetry made %d calls, want 1 (should stop waiting once ctx is done)", calls)
+	}
+}
+
+func TestWithRecoverConvertsPanicToError(t *testing.T) {
+	panics := func(ctx context.Context, s string) (string, error) {
+		panic("kaboom")
+	}
+
+	p := Wrap(panics, WithRecover())
+	_, err := p(context.Background(), "x")
+	if err == nil {
+		t.Fatalf("WithRecover: want error from recovered panic, got nil")
+	}
+}
+
+func TestWithTimingObservesDuration(t *testing.T) {
+	var observed time.Duration
+	slow := func(ctx context.Context, s string) (string, error) {
+		time.Sleep(time.Millisecond)
+		return s, nil
+	}
+
+	p := Wrap(slow, WithTiming(func(d time.Duration) { observed = d }))
+	if _, err := p(context.Background(), "x"); err != nil {
+		t.Fatalf("WithTiming: %v", err)
+	}
+	if observed <= 0 {
+		t.Fatalf("WithTiming observed duration = %v, want > 0", observed)
+	}
+}
+
+func TestWithLoggingReportsOutputAndError(t *testing.T) {
+	var logged string
+	log := func(format string, args ...interface{}) {
+		logged = fmt.Sprintf(format, args...)
+	}
+
+	ok := func(ctx context.Context, s string) (string, error) { return "out", nil }
+	p := Wrap(ok, WithLogging("name", log))
+	if _, err := p(context.Background(), "in"); err != nil {
+		t.Fatalf("WithLogging: %v", err)
+	}
+	if logged == "" {
+		t.Fatalf("WithLogging did not call log for a successful call")
+	}
+}
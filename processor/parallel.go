@@ -0,0 +1,34 @@
+package processor
+
+import (
+	"context"
+	"strings"
+)
+
+// Parallel runs processors concurrently against the same input and joins
+// their results, in processor order, once all have finished. It returns the
+// first error encountered (by processor order, not completion order).
+func Parallel(processors ...ProcessorFunc) ProcessorFunc {
+	return func(ctx context.Context, s string) (string, error) {
+		results := make([]string, len(processors))
+		errs := make([]error, len(processors))
+
+		done := make(chan int, len(processors))
+		for i, p := range processors {
+			go func(i int, p ProcessorFunc) {
+				results[i], errs[i] = p(ctx, s)
+				done <- i
+			}(i, p)
+		}
+		for range processors {
+			<-done
+		}
+
+		for _, err := range errs {
+			if err != nil {
+				return "", err
+			}
+		}
+		return strings.Join(results, ""), nil
+	}
+}
@@ -0,0 +1,49 @@
+// Package processor provides context-aware string-processing pipelines
+// with composable middleware.
+package processor
+
+import (
+	"context"
+	"strings"
+)
+
+// ProcessorFunc processes a string, respecting ctx for cancellation and
+// returning an error to short-circuit a chain.
+type ProcessorFunc func(ctx context.Context, s string) (string, error)
+
+// ChainProcessors runs input through processors in order, feeding each
+// result into the next. It stops and returns the error from the first
+// processor that fails, or ctx.Err() if ctx is done before the next
+// processor runs.
+func ChainProcessors(ctx context.Context, input string, processors ...ProcessorFunc) (string, error) {
+	result := input
+	for _, processor := range processors {
+		select {
+		case <-ctx.Done():
+			return result, ctx.Err()
+		default:
+		}
+
+		var err error
+		result, err = processor(ctx, result)
+		if err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+// UppercaseProcessor converts to uppercase.
+func UppercaseProcessor(ctx context.Context, s string) (string, error) {
+	return strings.ToUpper(s), nil
+}
+
+// LowercaseProcessor converts to lowercase.
+func LowercaseProcessor(ctx context.Context, s string) (string, error) {
+	return strings.ToLower(s), nil
+}
+
+// TrimProcessor removes leading and trailing whitespace.
+func TrimProcessor(ctx context.Context, s string) (string, error) {
+	return strings.TrimSpace(s), nil
+}
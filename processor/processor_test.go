@@ -0,0 +1,102 @@
+package processor
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestChainProcessorsAppliesInOrder(t *testing.T) {
+	out, err := ChainProcessors(context.Background(), "  Hello  ", TrimProcessor, UppercaseProcessor)
+	if err != nil {
+		t.Fatalf("ChainProcessors: %v", err)
+	}
+	if out != "HELLO" {
+		t.Fatalf("ChainProcessors = %q, want %q", out, "HELLO")
+	}
+}
+
+func TestChainProcessorsStopsOnFirstError(t *testing.T) {
+	wantErr := errors.New("boom")
+	failing := func(ctx context.Context, s string) (string, error) { return s, wantErr }
+	called := false
+	never := func(ctx context.Context, s string) (string, error) {
+		called = true
+		return s, nil
+	}
+
+	_, err := ChainProcessors(context.Background(), "x", failing, never)
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("ChainProcessors error = %v, want %v", err, wantErr)
+	}
+	if called {
+		t.Fatalf("processor after the failing one should not have run")
+	}
+}
+
+func TestChainProcessorsRespectsCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := ChainProcessors(ctx, "x", UppercaseProcessor)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("ChainProcessors on cancelled ctx: got %v, want context.Canceled", err)
+	}
+}
+
+func TestChainUsesMiddlewareAndProcessorsInOrder(t *testing.T) {
+	var order []string
+	mark := func(name string) Middleware {
+		return func(next ProcessorFunc) ProcessorFunc {
+			return func(ctx context.Context, s string) (string, error) {
+				order = append(order, name)
+				return next(ctx, s)
+			}
+		}
+	}
+
+	c := NewChain(TrimProcessor, UppercaseProcessor).Use(mark("outer"), mark("inner"))
+	out, err := c.Run(context.Background(), "  hi  ")
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if out != "HI" {
+		t.Fatalf("Run = %q, want %q", out, "HI")
+	}
+
+	want := []string{"outer", "inner", "outer", "inner"}
+	if len(order) != len(want) {
+		t.Fatalf("middleware call order = %v, want %v", order, want)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Fatalf("middleware call order = %v, want %v", order, want)
+		}
+	}
+}
+
+func TestParallelJoinsInProcessorOrder(t *testing.T) {
+	first := func(ctx context.Context, s string) (string, error) { return "1", nil }
+	second := func(ctx context.Context, s string) (string, error) { return "2", nil }
+	third := func(ctx context.Context, s string) (string, error) { return "3", nil }
+
+	out, err := Parallel(first, second, third)(context.Background(), "x")
+	if err != nil {
+		t.Fatalf("Parallel: %v", err)
+	}
+	if out != "123" {
+		t.Fatalf("Parallel result = %q, want %q (must join by processor order, not completion order)", out, "123")
+	}
+}
+
+func TestParallelReturnsFirstProcessorOrderError(t *testing.T) {
+	errA := errors.New("a failed")
+	errB := errors.New("b failed")
+	failA := func(ctx context.Context, s string) (string, error) { return "", errA }
+	failB := func(ctx context.Context, s string) (string, error) { return "", errB }
+
+	_, err := Parallel(failA, failB)(context.Background(), "x")
+	if !errors.Is(err, errA) {
+		t.Fatalf("Parallel error = %v, want %v (first processor's error, by position)", err, errA)
+	}
+}
@@ -0,0 +1,217 @@
+package main
+
+import (
+	"math"
+)
+
+// Helper function 1
+func Helper1() string {
+	return "Helper1"
+}
+
+// Helper function 2
+func Helper2() string {
+	return "Helper2"
+}
+
+// Helper function 3
+func Helper3() string {
+	return "Helper3"
+}
+
+// Helper function 4
+func Helper4() string {
+	return "Helper4"
+}
+
+// Helper function 5
+func Helper5() string {
+	return "Helper5"
+}
+
+// Helper function 6
+func Helper6() string {
+	return "Helper6"
+}
+
+// Helper function 7
+func Helper7() string {
+	return "Helper7"
+}
+
+// Helper function 8
+func Helper8() string {
+	return "Helper8"
+}
+
+// Helper function 9
+func Helper9() string {
+	return "Helper9"
+}
+
+// Helper function 10
+func Helper10() string {
+	return "Helper10"
+}
+
+// Helper function 11
+func Helper11() string {
+	return "Helper11"
+}
+
+// Helper function 12
+func Helper12() string {
+	return "Helper12"
+}
+
+// Helper function 13
+func Helper13() string {
+	return "Helper13"
+}
+
+// Helper function 14
+func Helper14() string {
+	return "Helper14"
+}
+
+// Helper function 15
+func Helper15() string {
+	return "Helper15"
+}
+
+// Helper function 16
+func Helper16() string {
+	return "Helper16"
+}
+
+// Helper function 17
+func Helper17() string {
+	return "Helper17"
+}
+
+// Helper function 18
+func Helper18() string {
+	return "Helper18"
+}
+
+// Helper function 19
+func Helper19() string {
+	return "Helper19"
+}
+
+// Helper function 20
+func Helper20() string {
+	return "Helper20"
+}
+
+// Helper function 21
+func Helper21() string {
+	return "Helper21"
+}
+
+// Helper function 22
+func Helper22() string {
+	return "Helper22"
+}
+
+// Helper function 23
+func Helper23() string {
+	return "Helper23"
+}
+
+// Helper function 24
+func Helper24() string {
+	return "Helper24"
+}
+
+// Helper function 25
+func Helper25() string {
+	return "Helper25"
+}
+
+// Helper function 26
+func Helper26() string {
+	return "Helper26"
+}
+
+// Helper function 27
+func Helper27() string {
+	return "Helper27"
+}
+
+// Helper function 28
+func Helper28() string {
+	return "Helper28"
+}
+
+// Helper function 29
+func Helper29() string {
+	return "Helper29"
+}
+
+// Helper function 30
+func Helper30() string {
+	return "Helper30"
+}
+
+// Calculator, LoggerInterface/SimpleLogger, ProcessorFunc/ChainProcessors, and
+// DataStore/MemoryStore moved to the calculator, logger, processor, and
+// datastore packages respectively, wrapped by cmd/dictator.
+
+// MathHelper provides utility functions
+type MathHelper struct{}
+
+// Power calculates x^y
+func (mh *MathHelper) Power(x, y float64) float64 {
+	return math.Pow(x, y)
+}
+
+// Sqrt calculates square root
+func (mh *MathHelper) Sqrt(x float64) float64 {
+	return math.Sqrt(x)
+}
+
+// Abs calculates absolute value
+func (mh *MathHelper) Abs(x float64) float64 {
+	return math.Abs(x)
+}
+
+// Floor floors a value
+func (mh *MathHelper) Floor(x float64) float64 {
+	return math.Floor(x)
+}
+
+// Ceil ceils a value
+func (mh *MathHelper) Ceil(x float64) float64 {
+	return math.Ceil(x)
+}
+
+// Round rounds a value
+func (mh *MathHelper) Round(x float64) float64 {
+	return math.Round(x)
+}
+
+// StringHelper provides string utilities
+type StringHelper struct{}
+
+// IsEmpty checks if string is empty
+func (sh *StringHelper) IsEmpty(s string) bool {
+	return len(strings.TrimSpace(s)) == 0
+}
+
+// Reverse reverses a string
+func (sh *StringHelper) Reverse(s string) string {
+	runes := []rune(s)
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		runes[i], runes[j] = runes[j], runes[i]
+	}
+	return string(runes)
+}
+
+// CountOccurrences counts substring occurrences
+func (sh *StringHelper) CountOccurrences(s, substr string) int {
+	return strings.Count(s, substr)
+}
+
+// main moved to cmd/dictator; this file now only holds the Helper/MathHelper/
+// StringHelper fixtures.